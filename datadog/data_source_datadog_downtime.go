@@ -0,0 +1,241 @@
+package datadog
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceDatadogDowntime() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to retrieve information about an existing downtime, for example one created outside of Terraform by the Datadog UI or an incident-response automation.",
+		Read:        dataSourceDatadogDowntimeRead,
+
+		Schema: map[string]*schema.Schema{
+			"downtime_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"filter"},
+				Description:   "ID of the downtime to look up. Exactly one of `downtime_id` or `filter` must be set.",
+			},
+			"filter": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"downtime_id"},
+				Description:   "Filter to use instead of looking the downtime up by `downtime_id`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"monitor_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Only match downtimes scoped to this monitor id.",
+						},
+						"scope": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Only match downtimes whose scope list contains this value.",
+						},
+						"monitor_tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Only match downtimes whose monitor tags contain all of these values.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"active_only": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Only match downtimes that are currently active.",
+						},
+						"match_first": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "If more than one downtime matches the filter, use the first match instead of raising an error.",
+						},
+					},
+				},
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "When true indicates this downtime is being actively applied",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "When true indicates this downtime is not being applied",
+			},
+			"start": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "When this downtime starts",
+			},
+			"end": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "When this downtime ends",
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The timezone for the downtime",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A message to include with notifications for this downtime",
+			},
+			"scope": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The scope(s) to which the downtime applies",
+			},
+			"monitor_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The monitor id to which this downtime applies, if any",
+			},
+			"monitor_tags": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The monitor tags to which this downtime applies, if any",
+			},
+		},
+	}
+}
+
+func dataSourceDatadogDowntimeRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	var dt datadogV1.Downtime
+
+	if attr, ok := d.GetOk("downtime_id"); ok {
+		id := int64(attr.(int))
+		downtime, httpresp, err := datadogClientV1.DowntimesApi.GetDowntime(authV1, id)
+		if err != nil {
+			return utils.TranslateClientError(err, httpresp.Request.URL.Host, "error getting downtime")
+		}
+		dt = downtime
+	} else if _, ok := d.GetOk("filter"); ok {
+		downtimes, httpresp, err := datadogClientV1.DowntimesApi.ListDowntimes(authV1)
+		if err != nil {
+			return utils.TranslateClientError(err, httpresp.Request.URL.Host, "error listing downtimes")
+		}
+
+		matched, err := filterDowntimes(downtimes, d)
+		if err != nil {
+			return err
+		}
+		dt = matched
+	} else {
+		return fmt.Errorf("exactly one of `downtime_id` or `filter` must be set")
+	}
+
+	d.SetId(strconv.Itoa(int(dt.GetId())))
+	return updateDowntimeDatasourceState(d, &dt)
+}
+
+func filterDowntimes(downtimes []datadogV1.Downtime, d *schema.ResourceData) (datadogV1.Downtime, error) {
+	monitorID, monitorIDOk := d.GetOk("filter.0.monitor_id")
+	scope, scopeOk := d.GetOk("filter.0.scope")
+	activeOnly := d.Get("filter.0.active_only").(bool)
+	matchFirst := d.Get("filter.0.match_first").(bool)
+
+	tags := make(map[string]bool)
+	for _, t := range d.Get("filter.0.monitor_tags").(*schema.Set).List() {
+		tags[t.(string)] = true
+	}
+
+	var matches []datadogV1.Downtime
+	for _, dt := range downtimes {
+		if monitorIDOk && dt.GetMonitorId() != int64(monitorID.(int)) {
+			continue
+		}
+		if scopeOk && !containsString(dt.GetScope(), scope.(string)) {
+			continue
+		}
+		if activeOnly && !dt.GetActive() {
+			continue
+		}
+		if len(tags) > 0 {
+			monitorTags := make(map[string]bool)
+			for _, t := range dt.GetMonitorTags() {
+				monitorTags[t] = true
+			}
+			allPresent := true
+			for t := range tags {
+				if !monitorTags[t] {
+					allPresent = false
+					break
+				}
+			}
+			if !allPresent {
+				continue
+			}
+		}
+		matches = append(matches, dt)
+	}
+
+	if len(matches) == 0 {
+		return datadogV1.Downtime{}, fmt.Errorf("your filter did not match any downtime")
+	}
+	if len(matches) > 1 && !matchFirst {
+		return datadogV1.Downtime{}, fmt.Errorf("your filter matched %d downtimes; set `match_first = true` to use the first match", len(matches))
+	}
+
+	return matches[0], nil
+}
+
+func containsString(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
+
+func updateDowntimeDatasourceState(d *schema.ResourceData, dt *datadogV1.Downtime) error {
+	if err := d.Set("downtime_id", int(dt.GetId())); err != nil {
+		return err
+	}
+	if err := d.Set("active", dt.GetActive()); err != nil {
+		return err
+	}
+	if err := d.Set("disabled", dt.GetDisabled()); err != nil {
+		return err
+	}
+	if err := d.Set("start", dt.GetStart()); err != nil {
+		return err
+	}
+	if err := d.Set("end", dt.GetEnd()); err != nil {
+		return err
+	}
+	if err := d.Set("timezone", dt.GetTimezone()); err != nil {
+		return err
+	}
+	if err := d.Set("message", dt.GetMessage()); err != nil {
+		return err
+	}
+	if err := d.Set("scope", dt.GetScope()); err != nil {
+		return err
+	}
+	if v, ok := dt.GetMonitorIdOk(); ok && v != nil {
+		if err := d.Set("monitor_id", v); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("monitor_tags", dt.GetMonitorTags()); err != nil {
+		return err
+	}
+	return nil
+}
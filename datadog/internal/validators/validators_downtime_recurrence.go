@@ -0,0 +1,49 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+)
+
+// validRRuleFreq are the FREQ values defined by RFC 5545 section 3.3.10.
+var validRRuleFreq = map[string]bool{
+	"SECONDLY": true,
+	"MINUTELY": true,
+	"HOURLY":   true,
+	"DAILY":    true,
+	"WEEKLY":   true,
+	"MONTHLY":  true,
+	"YEARLY":   true,
+}
+
+// ValidateDatadogDowntimeRecurrenceRRule validates that the given value is a syntactically
+// plausible RRULE string, so that a malformed rule fails during `terraform plan` rather than
+// at apply time. It checks that the rule is made of `NAME=VALUE` parts and that a recognized
+// `FREQ` is present, without attempting to fully parse the rule per RFC 5545.
+func ValidateDatadogDowntimeRecurrenceRRule(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var freq string
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			errors = append(errors, fmt.Errorf("%q contains an empty RRULE part: %q", k, value))
+			continue
+		}
+		name, val, found := strings.Cut(part, "=")
+		if !found || name == "" || val == "" {
+			errors = append(errors, fmt.Errorf("%q has a malformed RRULE part %q, expected `NAME=VALUE`: %q", k, part, value))
+			continue
+		}
+		if strings.ToUpper(name) == "FREQ" {
+			freq = strings.ToUpper(val)
+		}
+	}
+
+	if freq == "" {
+		errors = append(errors, fmt.Errorf("%q is missing the required `FREQ` part: %q", k, value))
+	} else if !validRRuleFreq[freq] {
+		errors = append(errors, fmt.Errorf("%q has an invalid `FREQ=%s`, must be one of SECONDLY, MINUTELY, HOURLY, DAILY, WEEKLY, MONTHLY, or YEARLY: %q", k, freq, value))
+	}
+
+	return ws, errors
+}
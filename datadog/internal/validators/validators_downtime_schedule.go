@@ -0,0 +1,17 @@
+package validators
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// ValidateDatadogDowntimeScheduleNotifyEndState validates that the given value is one of the
+// monitor states that may trigger an end-of-downtime notification: `alert`, `warn`, or `no data`.
+var ValidateDatadogDowntimeScheduleNotifyEndState = validation.StringInSlice([]string{
+	"alert", "warn", "no data",
+}, false)
+
+// ValidateDatadogDowntimeScheduleNotifyEndType validates that the given value is one of the
+// reasons a downtime may end and trigger a notification: `canceled` or `expired`.
+var ValidateDatadogDowntimeScheduleNotifyEndType = validation.StringInSlice([]string{
+	"canceled", "expired",
+}, false)
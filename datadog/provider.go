@@ -0,0 +1,33 @@
+package datadog
+
+import (
+	"context"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+	datadogV2 "github.com/DataDog/datadog-api-client-go/api/v2/datadog"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ProviderConfiguration holds the configured Datadog API clients shared across all
+// resources and data sources in this provider.
+type ProviderConfiguration struct {
+	DatadogClientV1 *datadogV1.APIClient
+	AuthV1          context.Context
+
+	DatadogClientV2 *datadogV2.APIClient
+	AuthV2          context.Context
+}
+
+// Provider returns the schema.Provider for the Datadog Terraform provider.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"datadog_downtime":          resourceDatadogDowntime(),
+			"datadog_downtime_schedule": resourceDatadogDowntimeSchedule(),
+			"datadog_downtime_bulk":     resourceDatadogDowntimeBulk(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"datadog_downtime": dataSourceDatadogDowntime(),
+		},
+	}
+}
@@ -22,7 +22,7 @@ import (
 
 func resourceDatadogDowntime() *schema.Resource {
 	return &schema.Resource{
-		Description: "Provides a Datadog downtime resource. This can be used to create and manage Datadog downtimes.",
+		Description: "Provides a Datadog downtime resource. This can be used to create and manage Datadog downtimes. **Deprecated:** This resource is based on Datadog's v1 downtime API, which is scheduled for deprecation. Consider using `datadog_downtime_schedule`, which targets the v2 API and exposes a richer set of scheduling and notification attributes, for new downtimes.",
 		Create:      resourceDatadogDowntimeCreate,
 		Read:        resourceDatadogDowntimeRead,
 		Update:      resourceDatadogDowntimeUpdate,
@@ -91,54 +91,7 @@ func resourceDatadogDowntime() *schema.Resource {
 					return strings.TrimSpace(val.(string))
 				},
 			},
-			"recurrence": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				MaxItems:    1,
-				Description: "Optional recurring schedule for this downtime",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"period": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "How often to repeat as an integer. For example to repeat every 3 days, select a `type` of `days` and a `period` of `3`.",
-						},
-						"type": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validators.ValidateDatadogDowntimeRecurrenceType,
-							Description:  "One of `days`, `weeks`, `months`, or `years`",
-						},
-						"until_date": {
-							Type:          schema.TypeInt,
-							Optional:      true,
-							ConflictsWith: []string{"recurrence.until_occurrences"},
-							Description:   "The date at which the recurrence should end as a POSIX timestamp. `until_occurrences` and `until_date` are mutually exclusive.",
-						},
-						"until_occurrences": {
-							Type:          schema.TypeInt,
-							Optional:      true,
-							ConflictsWith: []string{"recurrence.until_date"},
-							Description:   "How many times the downtime will be rescheduled. `until_occurrences` and `until_date` are mutually exclusive.",
-						},
-						"week_days": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							Description: "A list of week days to repeat on. Choose from: `Mon`, `Tue`, `Wed`, `Thu`, `Fri`, `Sat` or `Sun`. Only applicable when `type` is `weeks`. First letter must be capitalized.",
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validators.ValidateDatadogDowntimeRecurrenceWeekDays,
-							},
-						},
-						"rrule": {
-							Description:   "The RRULE standard for defining recurring events. For example, to have a recurring event on the first day of each month, use `FREQ=MONTHLY;INTERVAL=1`. Most common rrule options from the iCalendar Spec are supported. Attributes specifying the duration in RRULE are not supported (for example, `DTSTART`, `DTEND`, `DURATION`).",
-							Type:          schema.TypeString,
-							Optional:      true,
-							ConflictsWith: []string{"recurrence.period", "recurrence.until_date", "recurrence.until_occurrences", "recurrence.week_days"},
-						},
-					},
-				},
-			},
+			"recurrence": downtimeRecurrenceSchema(),
 			"scope": {
 				Type:        schema.TypeList,
 				Required:    true,
@@ -167,6 +120,82 @@ func resourceDatadogDowntime() *schema.Resource {
 				ConflictsWith: []string{"monitor_id"},
 				Elem:          &schema.Schema{Type: schema.TypeString},
 			},
+			"mute_first_recovery_notification": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set to `true`, the first recovery notification during this downtime is muted.",
+			},
+			"notify_end_states": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "States that will trigger a monitor notification when the `notify_end_types` action occurs. Valid values are `alert`, `warn`, `no data`.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validators.ValidateDatadogDowntimeScheduleNotifyEndState,
+				},
+			},
+			"notify_end_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Actions that will trigger a monitor notification if the downtime is in the `notify_end_states` states. Valid values are `canceled`, `expired`.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validators.ValidateDatadogDowntimeScheduleNotifyEndType,
+				},
+			},
+		},
+	}
+}
+
+// downtimeRecurrenceSchema returns the "recurrence" block shared by resourceDatadogDowntime and the
+// per-element schema of datadog_downtime_bulk.
+func downtimeRecurrenceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Optional recurring schedule for this downtime. Multiple blocks may be supplied for composite recurrences (for example, weekdays plus the first Saturday of the month); note that the v1 downtime API only accepts a single recurrence, so only the first block is sent to Datadog and a warning is logged if more are supplied.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"period": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "How often to repeat as an integer. For example to repeat every 3 days, select a `type` of `days` and a `period` of `3`.",
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validators.ValidateDatadogDowntimeRecurrenceType,
+					Description:  "One of `days`, `weeks`, `months`, or `years`",
+				},
+				"until_date": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ConflictsWith: []string{"recurrence.until_occurrences"},
+					Description:   "The date at which the recurrence should end as a POSIX timestamp. `until_occurrences` and `until_date` are mutually exclusive.",
+				},
+				"until_occurrences": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ConflictsWith: []string{"recurrence.until_date"},
+					Description:   "How many times the downtime will be rescheduled. `until_occurrences` and `until_date` are mutually exclusive.",
+				},
+				"week_days": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "A list of week days to repeat on. Choose from: `Mon`, `Tue`, `Wed`, `Thu`, `Fri`, `Sat` or `Sun`. Only applicable when `type` is `weeks`. First letter must be capitalized.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validators.ValidateDatadogDowntimeRecurrenceWeekDays,
+					},
+				},
+				"rrule": {
+					Description:   "The RRULE standard for defining recurring events. For example, to have a recurring event on the first day of each month, use `FREQ=MONTHLY;INTERVAL=1`. Most common rrule options from the iCalendar Spec are supported. Attributes specifying the duration in RRULE are not supported (for example, `DTSTART`, `DTEND`, `DURATION`).",
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"recurrence.period", "recurrence.until_date", "recurrence.until_occurrences", "recurrence.week_days"},
+					ValidateFunc:  validators.ValidateDatadogDowntimeRecurrenceRRule,
+				},
+			},
 		},
 	}
 }
@@ -253,7 +282,12 @@ func buildDowntimeStruct(ctx context.Context, d *schema.ResourceData, client *da
 	if attr, ok := d.GetOk("monitor_id"); ok {
 		dt.SetMonitorId(int64(attr.(int)))
 	}
-	if _, ok := d.GetOk("recurrence"); ok {
+	if recurrences, ok := d.GetOk("recurrence"); ok {
+		if len(recurrences.([]interface{})) > 1 {
+			log.Printf("[WARN] downtime: the v1 downtime API only supports a single recurrence; " +
+				"only the first `recurrence` block is sent to Datadog, the rest are ignored")
+		}
+
 		var recurrence datadogV1.DowntimeRecurrence
 
 		if attr, ok := d.GetOk("recurrence.0.period"); ok {
@@ -292,6 +326,24 @@ func buildDowntimeStruct(ctx context.Context, d *schema.ResourceData, client *da
 	}
 	dt.SetMonitorTags(tags)
 
+	if attr, ok := d.GetOkExists("mute_first_recovery_notification"); ok {
+		dt.SetMuteFirstRecoveryNotification(attr.(bool))
+	}
+	if attr, ok := d.GetOk("notify_end_states"); ok {
+		notifyEndStates := make([]string, 0)
+		for _, s := range attr.(*schema.Set).List() {
+			notifyEndStates = append(notifyEndStates, s.(string))
+		}
+		dt.SetNotifyEndStates(notifyEndStates)
+	}
+	if attr, ok := d.GetOk("notify_end_types"); ok {
+		notifyEndTypes := make([]string, 0)
+		for _, t := range attr.(*schema.Set).List() {
+			notifyEndTypes = append(notifyEndTypes, t.(string))
+		}
+		dt.SetNotifyEndTypes(notifyEndTypes)
+	}
+
 	startValue, startAttrName := getDowntimeBoundaryTimestamp(d, "start_date", "start")
 	if downtimeBoundaryNeedsApply(d, startAttrName, currentStart, startValue, updating) {
 		dt.SetStart(startValue)
@@ -373,6 +425,21 @@ func updateDowntimeState(d *schema.ResourceData, dt *datadogV1.Downtime) error {
 	if err := d.Set("timezone", dt.GetTimezone()); err != nil {
 		return err
 	}
+	if v, ok := dt.GetMuteFirstRecoveryNotificationOk(); ok && v != nil {
+		if err := d.Set("mute_first_recovery_notification", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := dt.GetNotifyEndStatesOk(); ok && v != nil {
+		if err := d.Set("notify_end_states", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := dt.GetNotifyEndTypesOk(); ok && v != nil {
+		if err := d.Set("notify_end_types", v); err != nil {
+			return err
+		}
+	}
 
 	if r, ok := dt.GetRecurrenceOk(); ok && r != nil {
 		recurrence := make(map[string]interface{})
@@ -0,0 +1,492 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceDatadogDowntimeBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog resource for managing a large cohort of downtimes as a single Terraform resource, for example one downtime per host/service/tag value in a maintenance window. Each element of `downtime` is created, updated, or canceled independently against the v1 downtime API; `parallelism` controls how many of those calls are in flight at once.",
+		Create:      resourceDatadogDowntimeBulkCreate,
+		Read:        resourceDatadogDowntimeBulkRead,
+		Update:      resourceDatadogDowntimeBulkUpdate,
+		Delete:      resourceDatadogDowntimeBulkDelete,
+
+		Schema: map[string]*schema.Schema{
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				Description:  "The number of downtimes to create, update, or cancel concurrently.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"downtime": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "One block per downtime in the cohort.",
+				Elem: &schema.Resource{
+					Schema: downtimeBulkElementSchema(),
+				},
+			},
+		},
+	}
+}
+
+// downtimeBulkElementSchema returns the per-downtime schema used inside a datadog_downtime_bulk
+// resource's "downtime" blocks. It mirrors resourceDatadogDowntime's schema so that a single element
+// can be lifted in or out of a datadog_downtime_bulk resource without losing attributes, plus a
+// computed "downtime_id" used to track each element's Datadog id across partial failures so that
+// successful elements are not recreated on retry.
+func downtimeBulkElementSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"downtime_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The id Datadog assigned to this downtime.",
+		},
+		"message": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "An optional message to provide when creating the downtime, can include notification handles",
+		},
+		"scope": {
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "specify the group scope to which this downtime applies. For everything use '*'",
+		},
+		"monitor_id": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"monitor_tags"},
+			Description:   "When specified, this downtime will only apply to this monitor",
+		},
+		"monitor_tags": {
+			Type:          schema.TypeSet,
+			Optional:      true,
+			Description:   "A list of monitor tags (up to 25), i.e. tags that are applied directly to monitors to which the downtime applies",
+			ConflictsWith: []string{"monitor_id"},
+			Elem:          &schema.Schema{Type: schema.TypeString},
+		},
+		"start": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"downtime.start_date"},
+			Description:   "Specify when this downtime should start",
+		},
+		"start_date": {
+			Type:          schema.TypeString,
+			ValidateFunc:  validation.IsRFC3339Time,
+			ConflictsWith: []string{"downtime.start"},
+			Optional:      true,
+			Description:   "String representing date and time to start the downtime in RFC3339 format.",
+		},
+		"end": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"downtime.end_date"},
+			Description:   "Optionally specify an end date when this downtime should expire",
+		},
+		"end_date": {
+			Type:          schema.TypeString,
+			ValidateFunc:  validation.IsRFC3339Time,
+			ConflictsWith: []string{"downtime.end"},
+			Optional:      true,
+			Description:   "String representing date and time to end the downtime in RFC3339 format.",
+		},
+		"timezone": {
+			Type:         schema.TypeString,
+			Default:      "UTC",
+			Optional:     true,
+			Description:  "The timezone for the downtime, default UTC",
+			ValidateFunc: validators.ValidateDatadogDowntimeTimezone,
+		},
+		"recurrence": downtimeRecurrenceSchema(),
+		"mute_first_recovery_notification": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If set to `true`, the first recovery notification during this downtime is muted.",
+		},
+		"notify_end_states": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "States that will trigger a monitor notification when the `notify_end_types` action occurs. Valid values are `alert`, `warn`, `no data`.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validators.ValidateDatadogDowntimeScheduleNotifyEndState,
+			},
+		},
+		"notify_end_types": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Actions that will trigger a monitor notification if the downtime is in the `notify_end_states` states. Valid values are `canceled`, `expired`.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validators.ValidateDatadogDowntimeScheduleNotifyEndType,
+			},
+		},
+	}
+}
+
+func buildDowntimeBulkElementStruct(element map[string]interface{}) *datadogV1.Downtime {
+	var dt datadogV1.Downtime
+
+	if v := element["message"].(string); v != "" {
+		dt.SetMessage(v)
+	}
+	if v := element["monitor_id"].(int); v != 0 {
+		dt.SetMonitorId(int64(v))
+	}
+	var scope []string
+	for _, s := range element["scope"].([]interface{}) {
+		scope = append(scope, s.(string))
+	}
+	dt.SetScope(scope)
+	var tags []string
+	for _, mt := range element["monitor_tags"].(*schema.Set).List() {
+		tags = append(tags, mt.(string))
+	}
+	dt.SetMonitorTags(tags)
+	if v := downtimeBulkElementBoundary(element, "start_date", "start"); v != 0 {
+		dt.SetStart(v)
+	}
+	if v := downtimeBulkElementBoundary(element, "end_date", "end"); v != 0 {
+		dt.SetEnd(v)
+	}
+	if v := element["timezone"].(string); v != "" {
+		dt.SetTimezone(v)
+	}
+	if recurrences, ok := element["recurrence"].([]interface{}); ok && len(recurrences) > 0 {
+		if len(recurrences) > 1 {
+			log.Printf("[WARN] downtime_bulk: the v1 downtime API only supports a single recurrence; " +
+				"only the first `recurrence` block is sent to Datadog, the rest are ignored")
+		}
+		dt.SetRecurrence(buildDowntimeBulkElementRecurrence(recurrences[0].(map[string]interface{})))
+	}
+	if v := element["mute_first_recovery_notification"].(bool); v {
+		dt.SetMuteFirstRecoveryNotification(v)
+	}
+	if notifyEndStates, ok := element["notify_end_states"].(*schema.Set); ok && notifyEndStates.Len() > 0 {
+		states := make([]string, 0, notifyEndStates.Len())
+		for _, s := range notifyEndStates.List() {
+			states = append(states, s.(string))
+		}
+		dt.SetNotifyEndStates(states)
+	}
+	if notifyEndTypes, ok := element["notify_end_types"].(*schema.Set); ok && notifyEndTypes.Len() > 0 {
+		types := make([]string, 0, notifyEndTypes.Len())
+		for _, t := range notifyEndTypes.List() {
+			types = append(types, t.(string))
+		}
+		dt.SetNotifyEndTypes(types)
+	}
+
+	return &dt
+}
+
+// downtimeBulkElementBoundary mirrors getDowntimeBoundaryTimestamp for a "downtime" list element:
+// it prefers the RFC3339 date attribute over the raw timestamp one when both are set.
+func downtimeBulkElementBoundary(element map[string]interface{}, dateKey, tsKey string) int64 {
+	if v, ok := element[dateKey].(string); ok && v != "" {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t.Unix()
+		}
+	}
+	return int64(element[tsKey].(int))
+}
+
+func buildDowntimeBulkElementRecurrence(recurrence map[string]interface{}) datadogV1.DowntimeRecurrence {
+	var r datadogV1.DowntimeRecurrence
+
+	if v := recurrence["period"].(int); v != 0 {
+		r.SetPeriod(int32(v))
+	}
+	if v := recurrence["type"].(string); v != "" {
+		r.SetType(v)
+	}
+	if v := recurrence["until_date"].(int); v != 0 {
+		r.SetUntilDate(int64(v))
+	}
+	if v := recurrence["until_occurrences"].(int); v != 0 {
+		r.SetUntilOccurrences(int32(v))
+	}
+	if weekDays, ok := recurrence["week_days"].([]interface{}); ok && len(weekDays) > 0 {
+		days := make([]string, 0, len(weekDays))
+		for _, d := range weekDays {
+			days = append(days, d.(string))
+		}
+		r.SetWeekDays(days)
+	}
+	if v := recurrence["rrule"].(string); v != "" {
+		r.SetRrule(v)
+	}
+
+	return r
+}
+
+// downtimeBulkOutcome is what a single downtime's create/update/read/cancel call hands back to its
+// worker goroutine. `gone` distinguishes "this downtime was canceled or deleted out-of-band" (its
+// downtime_id must be cleared so the next apply recreates it) from "nothing changed" (dt is nil
+// because the element had no downtime_id to refresh in the first place).
+type downtimeBulkOutcome struct {
+	dt   *datadogV1.Downtime
+	gone bool
+}
+
+// downtimeBulkResult carries the outcome of a single downtime's create/update/read/cancel call back
+// from its worker goroutine, keyed by its index in the "downtime" list.
+type downtimeBulkResult struct {
+	index   int
+	outcome downtimeBulkOutcome
+	err     error
+}
+
+// runDowntimeBulk dispatches fn over each element of work using up to parallelism workers at once,
+// preserving the index of each element so results can be written back to the matching list entry.
+func runDowntimeBulk(work []map[string]interface{}, parallelism int, fn func(index int, element map[string]interface{}) (downtimeBulkOutcome, error)) []downtimeBulkResult {
+	results := make([]downtimeBulkResult, len(work))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+
+	for i, element := range work {
+		wg.Add(1)
+		go func(i int, element map[string]interface{}) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			outcome, err := fn(i, element)
+			results[i] = downtimeBulkResult{index: i, outcome: outcome, err: err}
+		}(i, element)
+	}
+
+	wg.Wait()
+	return results
+}
+
+func resourceDatadogDowntimeBulkCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	downtimes := d.Get("downtime").([]interface{})
+	parallelism := d.Get("parallelism").(int)
+
+	work := make([]map[string]interface{}, len(downtimes))
+	for i, downtime := range downtimes {
+		work[i] = downtime.(map[string]interface{})
+	}
+
+	results := runDowntimeBulk(work, parallelism, func(i int, element map[string]interface{}) (downtimeBulkOutcome, error) {
+		dt := buildDowntimeBulkElementStruct(element)
+		created, httpresp, err := datadogClientV1.DowntimesApi.CreateDowntime(authV1, *dt)
+		if err != nil {
+			return downtimeBulkOutcome{}, utils.TranslateClientError(err, httpresp.Request.URL.Host, "error creating downtime")
+		}
+		return downtimeBulkOutcome{dt: &created}, nil
+	})
+
+	return finishDowntimeBulkOperation(d, downtimes, results)
+}
+
+func resourceDatadogDowntimeBulkRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	downtimes := d.Get("downtime").([]interface{})
+	parallelism := d.Get("parallelism").(int)
+
+	work := make([]map[string]interface{}, len(downtimes))
+	for i, downtime := range downtimes {
+		work[i] = downtime.(map[string]interface{})
+	}
+
+	results := runDowntimeBulk(work, parallelism, func(i int, element map[string]interface{}) (downtimeBulkOutcome, error) {
+		id := int64(element["downtime_id"].(int))
+		if id == 0 {
+			// never created (e.g. a partial failure on a prior apply), nothing to refresh
+			return downtimeBulkOutcome{}, nil
+		}
+		dt, httpresp, err := datadogClientV1.DowntimesApi.GetDowntime(authV1, id)
+		if err != nil {
+			if httpresp != nil && httpresp.StatusCode == 404 {
+				return downtimeBulkOutcome{gone: true}, nil
+			}
+			return downtimeBulkOutcome{}, utils.TranslateClientError(err, httpresp.Request.URL.Host, "error getting downtime")
+		}
+		if canceled, ok := dt.GetCanceledOk(); ok && canceled != nil {
+			return downtimeBulkOutcome{gone: true}, nil
+		}
+		return downtimeBulkOutcome{dt: &dt}, nil
+	})
+
+	for i, result := range results {
+		if result.err != nil {
+			return fmt.Errorf("error reading downtime at index %d: %s", i, result.err.Error())
+		}
+		element := work[i]
+		if result.outcome.gone {
+			element["downtime_id"] = 0
+		} else if result.outcome.dt != nil {
+			applyDowntimeBulkElementState(element, result.outcome.dt)
+		}
+		downtimes[i] = element
+	}
+
+	return d.Set("downtime", downtimes)
+}
+
+// applyDowntimeBulkElementState copies the id, the notification settings and the recurrence that
+// Datadog returned for a single downtime back onto its "downtime" list element. The message, scope,
+// monitor_id, monitor_tags, timezone and start/end values are not refreshed from Datadog, so drift
+// in those attributes is not detected.
+func applyDowntimeBulkElementState(element map[string]interface{}, dt *datadogV1.Downtime) {
+	element["downtime_id"] = int(dt.GetId())
+	if v, ok := dt.GetMuteFirstRecoveryNotificationOk(); ok && v != nil {
+		element["mute_first_recovery_notification"] = *v
+	}
+	if v, ok := dt.GetNotifyEndStatesOk(); ok && v != nil {
+		element["notify_end_states"] = *v
+	}
+	if v, ok := dt.GetNotifyEndTypesOk(); ok && v != nil {
+		element["notify_end_types"] = *v
+	}
+	if r, ok := dt.GetRecurrenceOk(); ok && r != nil {
+		recurrence := map[string]interface{}{}
+		if v, ok := r.GetPeriodOk(); ok && v != nil {
+			recurrence["period"] = *v
+		}
+		if v, ok := r.GetTypeOk(); ok && v != nil {
+			recurrence["type"] = *v
+		}
+		if v, ok := r.GetUntilDateOk(); ok && v != nil {
+			recurrence["until_date"] = *v
+		}
+		if v, ok := r.GetUntilOccurrencesOk(); ok && v != nil {
+			recurrence["until_occurrences"] = *v
+		}
+		if r.GetWeekDays() != nil {
+			recurrence["week_days"] = *r.WeekDays
+		}
+		if v, ok := r.GetRruleOk(); ok && v != nil {
+			recurrence["rrule"] = *v
+		}
+		element["recurrence"] = []map[string]interface{}{recurrence}
+	}
+}
+
+func resourceDatadogDowntimeBulkUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	downtimes := d.Get("downtime").([]interface{})
+	parallelism := d.Get("parallelism").(int)
+
+	work := make([]map[string]interface{}, len(downtimes))
+	for i, downtime := range downtimes {
+		work[i] = downtime.(map[string]interface{})
+	}
+
+	results := runDowntimeBulk(work, parallelism, func(i int, element map[string]interface{}) (downtimeBulkOutcome, error) {
+		dt := buildDowntimeBulkElementStruct(element)
+		if id := int64(element["downtime_id"].(int)); id != 0 {
+			dt.SetId(id)
+			updated, httpresp, err := datadogClientV1.DowntimesApi.UpdateDowntime(authV1, id, *dt)
+			if err != nil {
+				return downtimeBulkOutcome{}, utils.TranslateClientError(err, httpresp.Request.URL.Host, "error updating downtime")
+			}
+			return downtimeBulkOutcome{dt: &updated}, nil
+		}
+		// this element failed to create on a prior apply, or was canceled out-of-band; (re)create it
+		created, httpresp, err := datadogClientV1.DowntimesApi.CreateDowntime(authV1, *dt)
+		if err != nil {
+			return downtimeBulkOutcome{}, utils.TranslateClientError(err, httpresp.Request.URL.Host, "error creating downtime")
+		}
+		return downtimeBulkOutcome{dt: &created}, nil
+	})
+
+	return finishDowntimeBulkOperation(d, downtimes, results)
+}
+
+func resourceDatadogDowntimeBulkDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	downtimes := d.Get("downtime").([]interface{})
+	parallelism := d.Get("parallelism").(int)
+
+	work := make([]map[string]interface{}, len(downtimes))
+	for i, downtime := range downtimes {
+		work[i] = downtime.(map[string]interface{})
+	}
+
+	results := runDowntimeBulk(work, parallelism, func(i int, element map[string]interface{}) (downtimeBulkOutcome, error) {
+		id := int64(element["downtime_id"].(int))
+		if id == 0 {
+			return downtimeBulkOutcome{}, nil
+		}
+		if httpresp, err := datadogClientV1.DowntimesApi.CancelDowntime(authV1, id); err != nil {
+			return downtimeBulkOutcome{}, utils.TranslateClientError(err, httpresp.Request.URL.Host, "error deleting downtime")
+		}
+		return downtimeBulkOutcome{}, nil
+	})
+
+	var errs []string
+	for i, result := range results {
+		if result.err != nil {
+			errs = append(errs, fmt.Sprintf("index %d: %s", i, result.err.Error()))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to cancel %d downtime(s): %s", len(errs), errs)
+	}
+
+	return nil
+}
+
+// finishDowntimeBulkOperation writes each result's downtime_id back to its list entry and sets the
+// resource's state even when some elements failed, so that a retried apply only touches the
+// elements that did not already succeed.
+func finishDowntimeBulkOperation(d *schema.ResourceData, downtimes []interface{}, results []downtimeBulkResult) error {
+	var errs []string
+	for i, result := range results {
+		element := downtimes[i].(map[string]interface{})
+		if result.err != nil {
+			errs = append(errs, fmt.Sprintf("index %d: %s", i, result.err.Error()))
+			continue
+		}
+		if result.outcome.dt != nil {
+			applyDowntimeBulkElementState(element, result.outcome.dt)
+		}
+		downtimes[i] = element
+	}
+
+	if d.Id() == "" {
+		d.SetId(strconv.Itoa(schema.HashString(fmt.Sprintf("%v", downtimes))))
+	}
+
+	if err := d.Set("downtime", downtimes); err != nil {
+		return err
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to apply %d of %d downtime(s), re-apply to retry the failed ones: %s", len(errs), len(downtimes), errs)
+	}
+
+	return nil
+}
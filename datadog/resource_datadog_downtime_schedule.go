@@ -0,0 +1,444 @@
+package datadog
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+
+	datadogV2 "github.com/DataDog/datadog-api-client-go/api/v2/datadog"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceDatadogDowntimeSchedule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog downtime schedule resource. This can be used to create and manage Datadog downtimes using the v2 API, which supports multiple schedule types and a richer set of notification controls than `datadog_downtime`.",
+		Create:      resourceDatadogDowntimeScheduleCreate,
+		Read:        resourceDatadogDowntimeScheduleRead,
+		Update:      resourceDatadogDowntimeScheduleUpdate,
+		Delete:      resourceDatadogDowntimeScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The timezone in which this downtime's schedule is displayed to users in the Datadog UI. This is distinct from the timezone of the `recurring` schedule's `rrule`, which already embeds its own timezone via `DTSTART`.",
+			},
+			"mute_first_recovery_notification": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set to `true`, the first recovery notification during this downtime is muted.",
+			},
+			"notify_end_states": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "States that will trigger a monitor notification when the `notify_end_types` action occurs.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validators.ValidateDatadogDowntimeScheduleNotifyEndState,
+				},
+			},
+			"notify_end_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Actions that will trigger a monitor notification if the downtime is in the `notify_end_states` states.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validators.ValidateDatadogDowntimeScheduleNotifyEndType,
+				},
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A message to include with notifications for this downtime.",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The scope to which the downtime applies. Must follow the [common search syntax](https://docs.datadoghq.com/logs/explorer/search_syntax/).",
+			},
+			"monitor_identifier": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The monitor identifier for the downtime, either a single `monitor_id` or a set of `monitor_tags`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"monitor_id": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ConflictsWith: []string{"monitor_identifier.0.monitor_tags"},
+							Description:   "ID of the monitor to mute. Exactly one of `monitor_id` or `monitor_tags` must be set.",
+						},
+						"monitor_tags": {
+							Type:          schema.TypeSet,
+							Optional:      true,
+							ConflictsWith: []string{"monitor_identifier.0.monitor_id"},
+							Description:   "A list of monitor tags. For example, tags that are applied directly to monitors to mute.",
+							Elem:          &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"schedule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The schedule for the downtime, either `one_time` or `recurring`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"one_time": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"schedule.0.recurring"},
+							Description:   "Configuration for a downtime that occurs only once.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+										Description:  "RFC3339 datetime at which the downtime should start.",
+									},
+									"end": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+										Description:  "RFC3339 datetime at which the downtime should end.",
+									},
+								},
+							},
+						},
+						"recurring": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ConflictsWith: []string{"schedule.0.one_time"},
+							Description:   "Configuration for a recurring downtime, expressed as a list of RRULEs each with their own start time and duration.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rrule": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The RRULE standard for defining recurring events. For example, to have a recurring event on the first day of each month, use `FREQ=MONTHLY;INTERVAL=1`.",
+									},
+									"start": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+										Description:  "RFC3339 datetime of the first occurrence of this recurrence.",
+									},
+									"duration": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The length of the downtime in ISO 8601 duration format, for example `PT1H` for one hour.",
+									},
+									"exdate": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "RFC3339 datetimes of occurrences of this RRULE to exclude from the schedule, for example to skip a single recurrence that falls on a holiday.",
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.IsRFC3339Time,
+										},
+									},
+									"rdate": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "RFC3339 datetimes to explicitly include as occurrences of this RRULE, in addition to those the rule already generates.",
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.IsRFC3339Time,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildDowntimeScheduleCreateRequest(d *schema.ResourceData) (*datadogV2.DowntimeCreateRequest, error) {
+	attributes := datadogV2.NewDowntimeCreateRequestAttributesWithDefaults()
+
+	if attr, ok := d.GetOk("display_timezone"); ok {
+		attributes.SetDisplayTimezone(attr.(string))
+	}
+	if attr, ok := d.GetOkExists("mute_first_recovery_notification"); ok {
+		attributes.SetMuteFirstRecoveryNotification(attr.(bool))
+	}
+	if attr, ok := d.GetOk("notify_end_states"); ok {
+		states := make([]datadogV2.DowntimeNotifyEndStateStates, 0)
+		for _, s := range attr.([]interface{}) {
+			states = append(states, datadogV2.DowntimeNotifyEndStateStates(s.(string)))
+		}
+		attributes.SetNotifyEndStates(states)
+	}
+	if attr, ok := d.GetOk("notify_end_types"); ok {
+		types := make([]datadogV2.DowntimeNotifyEndStateActions, 0)
+		for _, t := range attr.([]interface{}) {
+			types = append(types, datadogV2.DowntimeNotifyEndStateActions(t.(string)))
+		}
+		attributes.SetNotifyEndTypes(types)
+	}
+	if attr, ok := d.GetOk("message"); ok {
+		attributes.SetMessage(attr.(string))
+	}
+	attributes.SetScope(d.Get("scope").(string))
+
+	monitorIdentifier, err := buildDowntimeScheduleMonitorIdentifier(d)
+	if err != nil {
+		return nil, err
+	}
+	attributes.SetMonitorIdentifier(*monitorIdentifier)
+
+	schedule, err := buildDowntimeScheduleSchedule(d)
+	if err != nil {
+		return nil, err
+	}
+	attributes.SetSchedule(*schedule)
+
+	req := datadogV2.NewDowntimeCreateRequestWithDefaults()
+	req.Data = *datadogV2.NewDowntimeCreateRequestDataWithDefaults()
+	req.Data.SetAttributes(*attributes)
+
+	return req, nil
+}
+
+func buildDowntimeScheduleMonitorIdentifier(d *schema.ResourceData) (*datadogV2.DowntimeMonitorIdentifier, error) {
+	if _, ok := d.GetOk("monitor_identifier.0.monitor_id"); ok {
+		identifier := datadogV2.DowntimeMonitorIdentifierId{
+			MonitorId: datadogV2.PtrInt64(int64(d.Get("monitor_identifier.0.monitor_id").(int))),
+		}
+		return &datadogV2.DowntimeMonitorIdentifier{DowntimeMonitorIdentifierId: &identifier}, nil
+	}
+	if attr, ok := d.GetOk("monitor_identifier.0.monitor_tags"); ok {
+		tags := make([]string, 0)
+		for _, t := range attr.(*schema.Set).List() {
+			tags = append(tags, t.(string))
+		}
+		identifier := datadogV2.DowntimeMonitorIdentifierTags{MonitorTags: tags}
+		return &datadogV2.DowntimeMonitorIdentifier{DowntimeMonitorIdentifierTags: &identifier}, nil
+	}
+	return nil, fmt.Errorf("exactly one of `monitor_id` or `monitor_tags` must be set in `monitor_identifier`")
+}
+
+func buildDowntimeScheduleSchedule(d *schema.ResourceData) (*datadogV2.DowntimeScheduleCreateRequest, error) {
+	if _, ok := d.GetOk("schedule.0.one_time"); ok {
+		oneTime := datadogV2.NewDowntimeScheduleOneTimeCreateUpdateRequestWithDefaults()
+		if attr, ok := d.GetOk("schedule.0.one_time.0.start"); ok {
+			oneTime.SetStart(attr.(string))
+		}
+		if attr, ok := d.GetOk("schedule.0.one_time.0.end"); ok {
+			oneTime.SetEnd(attr.(string))
+		}
+		return &datadogV2.DowntimeScheduleCreateRequest{DowntimeScheduleOneTimeCreateUpdateRequest: oneTime}, nil
+	}
+
+	if attr, ok := d.GetOk("schedule.0.recurring"); ok {
+		recurrences := make([]datadogV2.DowntimeScheduleRecurrencesRecurrence, 0)
+		for i := range attr.([]interface{}) {
+			recurrence := datadogV2.NewDowntimeScheduleRecurrencesRecurrenceWithDefaults()
+			recurrence.SetRrule(d.Get(fmt.Sprintf("schedule.0.recurring.%d.rrule", i)).(string))
+			recurrence.SetDuration(d.Get(fmt.Sprintf("schedule.0.recurring.%d.duration", i)).(string))
+			if start, ok := d.GetOk(fmt.Sprintf("schedule.0.recurring.%d.start", i)); ok {
+				recurrence.SetStart(start.(string))
+			}
+			if attr, ok := d.GetOk(fmt.Sprintf("schedule.0.recurring.%d.exdate", i)); ok {
+				exdate := make([]string, 0)
+				for _, e := range attr.([]interface{}) {
+					exdate = append(exdate, e.(string))
+				}
+				recurrence.SetExdate(exdate)
+			}
+			if attr, ok := d.GetOk(fmt.Sprintf("schedule.0.recurring.%d.rdate", i)); ok {
+				rdate := make([]string, 0)
+				for _, r := range attr.([]interface{}) {
+					rdate = append(rdate, r.(string))
+				}
+				recurrence.SetRdate(rdate)
+			}
+			recurrences = append(recurrences, *recurrence)
+		}
+		recurring := datadogV2.NewDowntimeScheduleRecurrencesCreateUpdateRequestWithDefaults()
+		recurring.SetRecurrences(recurrences)
+		return &datadogV2.DowntimeScheduleCreateRequest{DowntimeScheduleRecurrencesCreateUpdateRequest: recurring}, nil
+	}
+
+	return nil, fmt.Errorf("exactly one of `one_time` or `recurring` must be set in `schedule`")
+}
+
+func resourceDatadogDowntimeScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV2 := providerConf.DatadogClientV2
+	authV2 := providerConf.AuthV2
+
+	req, err := buildDowntimeScheduleCreateRequest(d)
+	if err != nil {
+		return fmt.Errorf("failed to parse resource configuration: %s", err.Error())
+	}
+
+	resp, httpresp, err := datadogClientV2.DowntimesApi.CreateDowntime(authV2, *req)
+	if err != nil {
+		return utils.TranslateClientError(err, httpresp.Request.URL.Host, "error creating downtime")
+	}
+
+	d.SetId(resp.Data.GetId())
+
+	return updateDowntimeScheduleState(d, &resp)
+}
+
+func resourceDatadogDowntimeScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV2 := providerConf.DatadogClientV2
+	authV2 := providerConf.AuthV2
+
+	resp, httpresp, err := datadogClientV2.DowntimesApi.GetDowntime(authV2, d.Id())
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return utils.TranslateClientError(err, httpresp.Request.URL.Host, "error getting downtime")
+	}
+
+	return updateDowntimeScheduleState(d, &resp)
+}
+
+func updateDowntimeScheduleState(d *schema.ResourceData, resp *datadogV2.DowntimeResponse) error {
+	attributes := resp.Data.GetAttributes()
+
+	if v, ok := attributes.GetDisplayTimezoneOk(); ok {
+		if err := d.Set("display_timezone", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := attributes.GetMuteFirstRecoveryNotificationOk(); ok {
+		if err := d.Set("mute_first_recovery_notification", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := attributes.GetNotifyEndStatesOk(); ok {
+		if err := d.Set("notify_end_states", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := attributes.GetNotifyEndTypesOk(); ok {
+		if err := d.Set("notify_end_types", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := attributes.GetMessageOk(); ok {
+		if err := d.Set("message", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := attributes.GetScopeOk(); ok {
+		if err := d.Set("scope", v); err != nil {
+			return err
+		}
+	}
+	if v, ok := attributes.GetMonitorIdentifierOk(); ok && v != nil {
+		if err := d.Set("monitor_identifier", []map[string]interface{}{flattenDowntimeScheduleMonitorIdentifier(v)}); err != nil {
+			return err
+		}
+	}
+	if v, ok := attributes.GetScheduleOk(); ok && v != nil {
+		if err := d.Set("schedule", []map[string]interface{}{flattenDowntimeScheduleSchedule(v)}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flattenDowntimeScheduleMonitorIdentifier(identifier *datadogV2.DowntimeMonitorIdentifier) map[string]interface{} {
+	monitorIdentifier := make(map[string]interface{})
+
+	if id := identifier.DowntimeMonitorIdentifierId; id != nil {
+		if v, ok := id.GetMonitorIdOk(); ok {
+			monitorIdentifier["monitor_id"] = v
+		}
+	}
+	if tags := identifier.DowntimeMonitorIdentifierTags; tags != nil {
+		monitorIdentifier["monitor_tags"] = tags.GetMonitorTags()
+	}
+
+	return monitorIdentifier
+}
+
+func flattenDowntimeScheduleSchedule(sched *datadogV2.DowntimeScheduleCreateRequest) map[string]interface{} {
+	schedule := make(map[string]interface{})
+
+	if oneTime := sched.DowntimeScheduleOneTimeCreateUpdateRequest; oneTime != nil {
+		oneTimeMap := make(map[string]interface{})
+		if v, ok := oneTime.GetStartOk(); ok {
+			oneTimeMap["start"] = v
+		}
+		if v, ok := oneTime.GetEndOk(); ok {
+			oneTimeMap["end"] = v
+		}
+		schedule["one_time"] = []map[string]interface{}{oneTimeMap}
+	}
+
+	if recurring := sched.DowntimeScheduleRecurrencesCreateUpdateRequest; recurring != nil {
+		recurrences := make([]map[string]interface{}, 0, len(recurring.GetRecurrences()))
+		for _, recurrence := range recurring.GetRecurrences() {
+			recurrenceMap := map[string]interface{}{
+				"rrule":    recurrence.GetRrule(),
+				"duration": recurrence.GetDuration(),
+			}
+			if v, ok := recurrence.GetStartOk(); ok {
+				recurrenceMap["start"] = v
+			}
+			if v, ok := recurrence.GetExdateOk(); ok {
+				recurrenceMap["exdate"] = v
+			}
+			if v, ok := recurrence.GetRdateOk(); ok {
+				recurrenceMap["rdate"] = v
+			}
+			recurrences = append(recurrences, recurrenceMap)
+		}
+		schedule["recurring"] = recurrences
+	}
+
+	return schedule
+}
+
+func resourceDatadogDowntimeScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV2 := providerConf.DatadogClientV2
+	authV2 := providerConf.AuthV2
+
+	req, err := buildDowntimeScheduleCreateRequest(d)
+	if err != nil {
+		return fmt.Errorf("failed to parse resource configuration: %s", err.Error())
+	}
+	updateReq := datadogV2.DowntimeUpdateRequest{Data: datadogV2.DowntimeUpdateRequestData{Attributes: req.Data.Attributes}}
+
+	resp, httpresp, err := datadogClientV2.DowntimesApi.UpdateDowntime(authV2, d.Id(), updateReq)
+	if err != nil {
+		return utils.TranslateClientError(err, httpresp.Request.URL.Host, "error updating downtime")
+	}
+
+	return updateDowntimeScheduleState(d, &resp)
+}
+
+func resourceDatadogDowntimeScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV2 := providerConf.DatadogClientV2
+	authV2 := providerConf.AuthV2
+
+	if httpresp, err := datadogClientV2.DowntimesApi.CancelDowntime(authV2, d.Id()); err != nil {
+		return utils.TranslateClientError(err, httpresp.Request.URL.Host, "error deleting downtime")
+	}
+
+	return nil
+}